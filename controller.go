@@ -3,134 +3,682 @@ package main
 import (
 	"context"
 	"fmt"
+	"strconv"
+	"sync"
 	"time"
 
+	"github.com/go-logr/logr"
+	"golang.org/x/time/rate"
+
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/wait"
 	appinformers "k8s.io/client-go/informers/apps/v1"
 	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/scheme"
+	typedcorev1 "k8s.io/client-go/kubernetes/typed/core/v1"
 	applisters "k8s.io/client-go/listers/apps/v1"
 	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
 	"k8s.io/client-go/util/workqueue"
+
+	eksposev1alpha1 "github.com/abduln-netapp/k8s-controllers/pkg/apis/ekspose.dev/v1alpha1"
+	eksclientset "github.com/abduln-netapp/k8s-controllers/pkg/generated/clientset/versioned"
+	eksinformers "github.com/abduln-netapp/k8s-controllers/pkg/generated/informers/externalversions/ekspose.dev/v1alpha1"
+	ekslisters "github.com/abduln-netapp/k8s-controllers/pkg/generated/listers/ekspose.dev/v1alpha1"
+)
+
+// maxRetries bounds how often a key whose syncDeployment/syncEkspose keeps
+// failing is requeued: a failure is requeued while the key has been requeued
+// at most maxRetries times, and the first failure after that drops the key.
+const maxRetries = 5
+
+// Annotations on a Deployment that drive how ekspose exposes it.
+const (
+	annotationHost         = "ekspose.dev/host"
+	annotationPath         = "ekspose.dev/path"
+	annotationIngressClass = "ekspose.dev/ingress-class"
+	annotationPort         = "ekspose.dev/port"
+
+	defaultPath       = "/"
+	defaultServerPort = 80
 )
 
+// defaultCacheSyncTimeout bounds how long Run waits for informer caches to
+// sync before giving up, matching controller-runtime's default.
+const defaultCacheSyncTimeout = 2 * time.Minute
+
 type controller struct {
 	clientset      kubernetes.Interface
 	depLister      applisters.DeploymentLister
 	depCacheSynced cache.InformerSynced
-	queue          workqueue.RateLimitingInterface
+	queue          workqueue.TypedRateLimitingInterface[cache.ObjectName]
+
+	eksClientset   eksclientset.Interface
+	eksLister      ekslisters.EksposeLister
+	eksCacheSynced cache.InformerSynced
+	eksQueue       workqueue.TypedRateLimitingInterface[cache.ObjectName]
+
+	// CacheSyncTimeout bounds how long Run waits for informer caches to
+	// sync. Defaults to defaultCacheSyncTimeout.
+	CacheSyncTimeout time.Duration
+
+	logger   logr.Logger
+	recorder record.EventRecorder
+
+	// deletedUIDs remembers the UID a Deployment had at delete time, keyed
+	// by its ObjectName, so cleanupOwnedResources can tell "the Service/
+	// Ingress owned by the Deployment that just got deleted" apart from a
+	// same-named Deployment recreated in the meantime. Populated from the
+	// delete event's tombstone in handleDel, since the lister can no longer
+	// answer with the deleted object's UID by the time syncDeployment runs.
+	deletedUIDsMu sync.Mutex
+	deletedUIDs   map[cache.ObjectName]types.UID
+
+	// deletedEksposeSelections remembers, keyed by an Ekspose's ObjectName,
+	// which Deployments it last selected. syncEkspose can no longer list
+	// them by selector once the Ekspose is gone, but they still need
+	// re-enqueuing so syncDeployment runs cleanupOwnedResources and tears
+	// down the Service/Ingress that Ekspose turned on. Populated from the
+	// delete event's tombstone in handleEksposeDel.
+	deletedEksposeSelectionsMu sync.Mutex
+	deletedEksposeSelections   map[cache.ObjectName][]cache.ObjectName
 }
 
-func newController(clientset kubernetes.Interface, depInformer appinformers.DeploymentInformer) *controller {
+func newController(logger logr.Logger, clientset kubernetes.Interface, depInformer appinformers.DeploymentInformer, eksClientset eksclientset.Interface, eksInformer eksinformers.EksposeInformer) *controller {
+	eventBroadcaster := record.NewBroadcaster()
+	eventBroadcaster.StartStructuredLogging(0)
+	eventBroadcaster.StartRecordingToSink(&typedcorev1.EventSinkImpl{Interface: clientset.CoreV1().Events("")})
+	recorder := eventBroadcaster.NewRecorder(scheme.Scheme, corev1.EventSource{Component: "ekspose-controller"})
+
 	c := &controller{
 		clientset:      clientset,
 		depLister:      depInformer.Lister(),
 		depCacheSynced: depInformer.Informer().HasSynced,
-		queue:          workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), "ekspose"),
+		queue:          newObjectNameQueue("ekspose"),
+
+		eksClientset:   eksClientset,
+		eksLister:      eksInformer.Lister(),
+		eksCacheSynced: eksInformer.Informer().HasSynced,
+		eksQueue:       newObjectNameQueue("ekspose-ekspose"),
+
+		CacheSyncTimeout:         defaultCacheSyncTimeout,
+		logger:                   logger,
+		recorder:                 recorder,
+		deletedUIDs:              make(map[cache.ObjectName]types.UID),
+		deletedEksposeSelections: make(map[cache.ObjectName][]cache.ObjectName),
 	}
 
 	depInformer.Informer().AddEventHandler(
 		cache.ResourceEventHandlerFuncs{
 			AddFunc:    c.handleAdd,
+			UpdateFunc: func(oldObj, newObj interface{}) { c.handleAdd(newObj) },
 			DeleteFunc: c.handleDel,
 		},
 	)
 
+	eksInformer.Informer().AddEventHandler(
+		cache.ResourceEventHandlerFuncs{
+			AddFunc:    c.handleEksposeAdd,
+			UpdateFunc: func(oldObj, newObj interface{}) { c.handleEksposeAdd(newObj) },
+			DeleteFunc: c.handleEksposeDel,
+		},
+	)
+
 	return c
 }
 
-func (c *controller) run(ch <-chan struct{}) {
-	fmt.Println("starting controller")
-	if !cache.WaitForCacheSync(ch, c.depCacheSynced) {
-		fmt.Println("waiting for cache to be synced")
+// newObjectNameQueue builds the typed, rate-limited workqueue shared by the
+// Deployment and Ekspose reconcile loops.
+func newObjectNameQueue(name string) workqueue.TypedRateLimitingInterface[cache.ObjectName] {
+	rateLimiter := workqueue.NewTypedMaxOfRateLimiter(
+		workqueue.NewTypedItemExponentialFailureRateLimiter[cache.ObjectName](5*time.Millisecond, 1000*time.Second),
+		&workqueue.TypedBucketRateLimiter[cache.ObjectName]{Limiter: rate.NewLimiter(rate.Limit(10), 100)},
+	)
+	return workqueue.NewTypedRateLimitingQueueWithConfig(rateLimiter, workqueue.TypedRateLimitingQueueConfig[cache.ObjectName]{
+		Name: name,
+	})
+}
+
+// Run starts the controller: it waits for both informer caches to sync,
+// launches workers parallel workers for each of the Deployment and Ekspose
+// reconcile loops, and blocks until ctx is cancelled. On cancellation it
+// shuts both workqueues down and waits for every worker to drain before
+// returning.
+func (c *controller) Run(ctx context.Context, workers int) error {
+	c.logger.Info("starting controller")
+
+	syncCtx, cancel := context.WithTimeout(ctx, c.CacheSyncTimeout)
+	defer cancel()
+
+	if !cache.WaitForCacheSync(syncCtx.Done(), c.depCacheSynced, c.eksCacheSynced) {
+		return fmt.Errorf("timed out waiting for caches to sync")
 	}
 
-	go wait.Until(c.worker, 1*time.Second, ch)
+	var wg sync.WaitGroup
+	for i := 0; i < workers; i++ {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			wait.Until(func() { c.worker(ctx) }, time.Second, ctx.Done())
+		}()
+		go func() {
+			defer wg.Done()
+			wait.Until(func() { c.eksWorker(ctx) }, time.Second, ctx.Done())
+		}()
+	}
+
+	<-ctx.Done()
+	c.queue.ShutDown()
+	c.eksQueue.ShutDown()
+	wg.Wait()
 
-	<-ch
+	return nil
 }
 
-func (c *controller) worker() {
-	for c.processItem() {
+func (c *controller) worker(ctx context.Context) {
+	for c.processItem(ctx) {
 
 	}
 }
 
-func (c *controller) processItem() bool {
+func (c *controller) eksWorker(ctx context.Context) {
+	for c.processEksposeItem(ctx) {
+
+	}
+}
+
+func (c *controller) processItem(ctx context.Context) bool {
 	item, shutdown := c.queue.Get()
 	if shutdown {
 		return false
 	}
-	defer c.queue.Forget(item)
-	key, error := cache.MetaNamespaceKeyFunc(item)
-	if error != nil {
-		fmt.Printf("getting key from cache %s\n", error.Error())
+	defer c.queue.Done(item)
+
+	logger := c.logger.WithValues("namespace", item.Namespace, "name", item.Name)
+	err := c.syncDeployment(ctx, item.Namespace, item.Name)
+	if err == nil {
+		c.queue.Forget(item)
+		return true
 	}
 
-	ns, name, err := cache.SplitMetaNamespaceKey(key)
-	if err != nil {
-		fmt.Printf("spliting key into ns and name %s\n", err.Error())
-		return false
+	if c.queue.NumRequeues(item) <= maxRetries {
+		logger.Error(err, "syncing deployment, requeuing")
+		c.queue.AddRateLimited(item)
+		return true
 	}
 
-	err = c.syncDeployment(ns, name)
-	if err != nil {
-		// re-try
-		fmt.Printf("syncing deployment %s\n", err.Error())
+	logger.Error(err, "syncing deployment, dropping after max retries", "maxRetries", maxRetries)
+	if dep, depErr := c.depLister.Deployments(item.Namespace).Get(item.Name); depErr == nil {
+		c.recorder.Eventf(dep, corev1.EventTypeWarning, "SyncFailed", "syncing deployment: %v", err)
+	}
+	c.queue.Forget(item)
+	return true
+}
+
+func (c *controller) processEksposeItem(ctx context.Context) bool {
+	item, shutdown := c.eksQueue.Get()
+	if shutdown {
 		return false
 	}
+	defer c.eksQueue.Done(item)
 
+	logger := c.logger.WithValues("namespace", item.Namespace, "name", item.Name)
+	err := c.syncEkspose(ctx, item.Namespace, item.Name)
+	if err == nil {
+		c.eksQueue.Forget(item)
+		return true
+	}
+
+	if c.eksQueue.NumRequeues(item) <= maxRetries {
+		logger.Error(err, "syncing ekspose, requeuing")
+		c.eksQueue.AddRateLimited(item)
+		return true
+	}
+
+	logger.Error(err, "syncing ekspose, dropping after max retries", "maxRetries", maxRetries)
+	if eks, eksErr := c.eksLister.Eksposes(item.Namespace).Get(item.Name); eksErr == nil {
+		c.recorder.Eventf(eks, corev1.EventTypeWarning, "SyncFailed", "syncing ekspose: %v", err)
+	}
+	c.eksQueue.Forget(item)
 	return true
 }
 
-func (c *controller) syncDeployment(ns, name string) error {
+// syncEkspose requeues every Deployment the named Ekspose selects so that
+// syncDeployment picks up the (possibly new) exposure spec.
+func (c *controller) syncEkspose(ctx context.Context, ns, name string) error {
+	eks, err := c.eksLister.Eksposes(ns).Get(name)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			// the Ekspose is gone: the Deployments it used to select are
+			// no longer listable by selector, so fall back to what
+			// handleEksposeDel remembered and let syncDeployment tear
+			// down what it created for them.
+			for _, dep := range c.takeDeletedEksposeSelection(ns, name) {
+				c.queue.Add(dep)
+			}
+			return nil
+		}
+		return err
+	}
+
+	selector, err := metav1.LabelSelectorAsSelector(&eks.Spec.DeploymentSelector)
+	if err != nil {
+		return err
+	}
+
+	deps, err := c.depLister.Deployments(ns).List(selector)
+	if err != nil {
+		return err
+	}
+
+	for _, dep := range deps {
+		c.queue.Add(cache.ObjectName{Namespace: ns, Name: dep.Name})
+	}
+
+	return nil
+}
+
+// findMatchingEkspose returns the first active Ekspose in ns whose
+// deploymentSelector matches dep, or nil if none does.
+func (c *controller) findMatchingEkspose(ns string, dep *appsv1.Deployment) (*eksposev1alpha1.Ekspose, error) {
+	eksList, err := c.eksLister.Eksposes(ns).List(labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+
+	for _, eks := range eksList {
+		selector, err := metav1.LabelSelectorAsSelector(&eks.Spec.DeploymentSelector)
+		if err != nil {
+			c.logger.Error(err, "parsing deploymentSelector for ekspose", "namespace", ns, "ekspose", eks.Name)
+			continue
+		}
+		if selector.Matches(labels.Set(dep.Labels)) {
+			return eks, nil
+		}
+	}
+
+	return nil, nil
+}
+
+func (c *controller) syncDeployment(ctx context.Context, ns, name string) error {
+	logger := c.logger.WithValues("namespace", ns, "name", name)
+
 	// create service
-	ctx := context.Background()
 	dep, err := c.depLister.Deployments(ns).Get(name)
 	if err != nil {
-		fmt.Printf("getting deployment from lister %s\n", err.Error())
+		if apierrors.IsNotFound(err) {
+			// the Deployment is gone: garbage-collect anything it owns,
+			// scoped to the UID it had when deleted.
+			return c.cleanupOwnedResources(ctx, ns, name, c.takeDeletedUID(ns, name))
+		}
+		logger.Error(err, "getting deployment from lister")
+		return err
+	}
+
+	// dep is alive under its current UID: forget any stale UID recorded for
+	// a same-named Deployment that was deleted and recreated before its
+	// queued cleanup ran.
+	c.takeDeletedUID(ns, name)
+
+	eks, err := c.findMatchingEkspose(ns, dep)
+	if err != nil {
+		return err
+	}
+	if eks == nil {
+		// no Ekspose opts this Deployment into exposure: make sure nothing
+		// is left over from a Ekspose that used to match it. dep still
+		// exists, so its current UID is the one anything orphaned must
+		// have been owned by.
+		return c.cleanupOwnedResources(ctx, ns, name, dep.UID)
 	}
 
-	// labels := depLabels(dep)
+	port := servicePort(logger, *dep, eks)
+	serviceType := eks.Spec.ServiceType
+	if serviceType == "" {
+		serviceType = corev1.ServiceTypeClusterIP
+	}
+	ownerRef := *metav1.NewControllerRef(dep, appsv1.SchemeGroupVersion.WithKind("Deployment"))
 
 	svc := corev1.Service{
 		ObjectMeta: metav1.ObjectMeta{
-			Name:      dep.Name,
-			Namespace: ns,
+			Name:            dep.Name,
+			Namespace:       ns,
+			OwnerReferences: []metav1.OwnerReference{ownerRef},
 		},
 		Spec: corev1.ServiceSpec{
+			Type:     serviceType,
 			Selector: depLabels(*dep),
 			Ports: []corev1.ServicePort{
 				{
 					Name: "http",
-					Port: 80,
+					Port: port,
 				},
 			},
 		},
 	}
 
 	_, err = c.clientset.CoreV1().Services(ns).Create(ctx, &svc, metav1.CreateOptions{})
+	if err != nil && !apierrors.IsAlreadyExists(err) {
+		logger.Error(err, "creating service")
+		return err
+	}
+
+	status := eksposev1alpha1.EksposeStatus{ServiceName: dep.Name, LastSyncTime: metav1.Now()}
+
+	// create ingress
+	host, path, ingressClass, tlsSecret, ok := ingressParams(*dep, eks)
+	if ok {
+		ing := ingress(*dep, host, path, ingressClass, tlsSecret, port)
+		ing.OwnerReferences = []metav1.OwnerReference{ownerRef}
+		_, err = c.clientset.NetworkingV1().Ingresses(ns).Create(ctx, &ing, metav1.CreateOptions{})
+		if err != nil && !apierrors.IsAlreadyExists(err) {
+			logger.Error(err, "creating ingress")
+			return err
+		}
+		status.IngressName = dep.Name
+	}
+
+	c.updateEksposeStatus(ctx, logger, eks, status)
+
+	return nil
+}
+
+// updateEksposeStatus reports what syncDeployment just did back onto the
+// Ekspose's status subresource.
+func (c *controller) updateEksposeStatus(ctx context.Context, logger logr.Logger, eks *eksposev1alpha1.Ekspose, status eksposev1alpha1.EksposeStatus) {
+	updated := eks.DeepCopy()
+	updated.Status = status
+	if _, err := c.eksClientset.EksposeV1alpha1().Eksposes(eks.Namespace).UpdateStatus(ctx, updated, metav1.UpdateOptions{}); err != nil {
+		logger.Error(err, "updating ekspose status", "ekspose", eks.Name)
+	}
+}
+
+// cleanupOwnedResources garbage-collects the Service and Ingress ekspose
+// created for the Deployment ns/name, owned by the Deployment with the given
+// UID. After the Deployment is deleted it is only a backstop: Kubernetes' own
+// garbage collector already reclaims them through the owner references set
+// in syncDeployment. For a live Deployment that no Ekspose selects any more,
+// it is what removes them.
+func (c *controller) cleanupOwnedResources(ctx context.Context, ns, name string, uid types.UID) error {
+	logger := c.logger.WithValues("namespace", ns, "name", name)
+
+	svcs, err := c.clientset.CoreV1().Services(ns).List(ctx, metav1.ListOptions{})
 	if err != nil {
-		fmt.Printf("creating service %s\n", err.Error())
+		return err
+	}
+	for _, svc := range svcs.Items {
+		if !ownedByDeployment(svc.OwnerReferences, name, uid) {
+			continue
+		}
+		if err := c.clientset.CoreV1().Services(ns).Delete(ctx, svc.Name, metav1.DeleteOptions{}); err != nil && !apierrors.IsNotFound(err) {
+			logger.Error(err, "deleting orphaned service", "service", svc.Name)
+		}
 	}
 
-	//craete ingress
+	ings, err := c.clientset.NetworkingV1().Ingresses(ns).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return err
+	}
+	for _, ing := range ings.Items {
+		if !ownedByDeployment(ing.OwnerReferences, name, uid) {
+			continue
+		}
+		if err := c.clientset.NetworkingV1().Ingresses(ns).Delete(ctx, ing.Name, metav1.DeleteOptions{}); err != nil && !apierrors.IsNotFound(err) {
+			logger.Error(err, "deleting orphaned ingress", "ingress", ing.Name)
+		}
+	}
 
 	return nil
 }
 
+// ownedByDeployment reports whether refs contains a controller owner
+// reference pointing at the Deployment depName/uid. If uid is empty (the
+// delete event's tombstone UID was never recorded, e.g. across a controller
+// restart) it falls back to matching on kind+name alone, which can't
+// distinguish the deleted Deployment from one recreated with the same name.
+func ownedByDeployment(refs []metav1.OwnerReference, depName string, uid types.UID) bool {
+	for _, ref := range refs {
+		if ref.Kind != "Deployment" || ref.Name != depName || ref.Controller == nil || !*ref.Controller {
+			continue
+		}
+		if uid != "" && ref.UID != uid {
+			continue
+		}
+		return true
+	}
+	return false
+}
+
+// servicePort resolves the port ekspose should put the generated Service on.
+// The matched Ekspose's spec.servicePort wins; the ekspose.dev/port
+// annotation and then 80 are the fallbacks for Deployments matched without
+// one set.
+func servicePort(logger logr.Logger, dep appsv1.Deployment, eks *eksposev1alpha1.Ekspose) int32 {
+	if eks != nil && eks.Spec.ServicePort != 0 {
+		return eks.Spec.ServicePort
+	}
+
+	raw, ok := dep.Annotations[annotationPort]
+	if !ok || raw == "" {
+		return defaultServerPort
+	}
+
+	port, err := strconv.Atoi(raw)
+	if err != nil || port <= 0 {
+		logger.Error(err, "parsing annotation, falling back to default port", "annotation", annotationPort, "value", raw, "default", defaultServerPort)
+		return defaultServerPort
+	}
+
+	return int32(port)
+}
+
+// ingressParams resolves the host/path/class ekspose should route to, from
+// the matched Ekspose's spec.ingress or, failing that, the Deployment's
+// ekspose.dev/* annotations. ok is false when neither opts dep into an
+// Ingress.
+func ingressParams(dep appsv1.Deployment, eks *eksposev1alpha1.Ekspose) (host, path, ingressClass, tlsSecret string, ok bool) {
+	if eks != nil && eks.Spec.Ingress != nil {
+		path = eks.Spec.Ingress.Path
+		if path == "" {
+			path = defaultPath
+		}
+		return eks.Spec.Ingress.Host, path, eks.Spec.Ingress.IngressClassName, eks.Spec.Ingress.TLSSecretName, true
+	}
+
+	host = dep.Annotations[annotationHost]
+	if host == "" {
+		return "", "", "", "", false
+	}
+
+	path = dep.Annotations[annotationPath]
+	if path == "" {
+		path = defaultPath
+	}
+
+	return host, path, dep.Annotations[annotationIngressClass], "", true
+}
+
+// ingress builds the Ingress that routes host/path to the Service ekspose
+// creates for dep.
+func ingress(dep appsv1.Deployment, host, path, ingressClass, tlsSecret string, port int32) networkingv1.Ingress {
+	pathType := networkingv1.PathTypePrefix
+
+	var ingressClassName *string
+	if ingressClass != "" {
+		ingressClassName = &ingressClass
+	}
+
+	var tls []networkingv1.IngressTLS
+	if tlsSecret != "" {
+		tls = []networkingv1.IngressTLS{{Hosts: []string{host}, SecretName: tlsSecret}}
+	}
+
+	return networkingv1.Ingress{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      dep.Name,
+			Namespace: dep.Namespace,
+		},
+		Spec: networkingv1.IngressSpec{
+			IngressClassName: ingressClassName,
+			TLS:              tls,
+			Rules: []networkingv1.IngressRule{
+				{
+					Host: host,
+					IngressRuleValue: networkingv1.IngressRuleValue{
+						HTTP: &networkingv1.HTTPIngressRuleValue{
+							Paths: []networkingv1.HTTPIngressPath{
+								{
+									Path:     path,
+									PathType: &pathType,
+									Backend: networkingv1.IngressBackend{
+										Service: &networkingv1.IngressServiceBackend{
+											Name: dep.Name,
+											Port: networkingv1.ServiceBackendPort{
+												Number: port,
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
 func depLabels(dep appsv1.Deployment) map[string]string {
 	return dep.Spec.Template.Labels
 }
 
 func (c *controller) handleAdd(obj interface{}) {
-	fmt.Println("add was called")
-	c.queue.Add(obj)
+	c.logger.Info("add was called")
+	c.enqueue(c.queue, obj)
 }
 
 func (c *controller) handleDel(obj interface{}) {
-	fmt.Println("delete was called")
-	c.queue.Add(obj)
+	c.logger.Info("delete was called")
+	c.rememberDeletedUID(obj)
+	c.enqueue(c.queue, obj)
+}
+
+// rememberDeletedUID records the UID the deleted Deployment behind obj had,
+// so the eventual cleanupOwnedResources call for it can tell its owned
+// Service/Ingress apart from one belonging to a same-named Deployment
+// recreated before that cleanup runs. obj may be a
+// cache.DeletedFinalStateUnknown tombstone, the only place this UID is
+// still available once the Deployment itself is gone.
+func (c *controller) rememberDeletedUID(obj interface{}) {
+	if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+		obj = tombstone.Obj
+	}
+
+	dep, err := meta.Accessor(obj)
+	if err != nil {
+		c.logger.Error(err, "getting object meta for deleted deployment")
+		return
+	}
+
+	c.deletedUIDsMu.Lock()
+	defer c.deletedUIDsMu.Unlock()
+	c.deletedUIDs[cache.ObjectName{Namespace: dep.GetNamespace(), Name: dep.GetName()}] = dep.GetUID()
+}
+
+// takeDeletedUID returns and forgets the UID rememberDeletedUID recorded for
+// ns/name, or "" if none was recorded.
+func (c *controller) takeDeletedUID(ns, name string) types.UID {
+	key := cache.ObjectName{Namespace: ns, Name: name}
+
+	c.deletedUIDsMu.Lock()
+	defer c.deletedUIDsMu.Unlock()
+	uid := c.deletedUIDs[key]
+	delete(c.deletedUIDs, key)
+	return uid
+}
+
+func (c *controller) handleEksposeAdd(obj interface{}) {
+	c.logger.Info("ekspose add/update was called")
+	c.enqueue(c.eksQueue, obj)
+}
+
+func (c *controller) handleEksposeDel(obj interface{}) {
+	c.logger.Info("ekspose delete was called")
+	c.rememberDeletedEksposeSelection(obj)
+	c.enqueue(c.eksQueue, obj)
+}
+
+// rememberDeletedEksposeSelection records, from obj's tombstone, which
+// Deployments the just-deleted Ekspose selected, for takeDeletedEksposeSelection
+// to hand back to syncEkspose once the Ekspose itself is no longer listable.
+func (c *controller) rememberDeletedEksposeSelection(obj interface{}) {
+	if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+		obj = tombstone.Obj
+	}
+
+	eks, ok := obj.(*eksposev1alpha1.Ekspose)
+	if !ok {
+		c.logger.Error(fmt.Errorf("unexpected object type %T", obj), "remembering deleted ekspose selection")
+		return
+	}
+
+	selector, err := metav1.LabelSelectorAsSelector(&eks.Spec.DeploymentSelector)
+	if err != nil {
+		c.logger.Error(err, "parsing deploymentSelector for deleted ekspose", "namespace", eks.Namespace, "name", eks.Name)
+		return
+	}
+
+	deps, err := c.depLister.Deployments(eks.Namespace).List(selector)
+	if err != nil {
+		c.logger.Error(err, "listing deployments for deleted ekspose", "namespace", eks.Namespace, "name", eks.Name)
+		return
+	}
+
+	selected := make([]cache.ObjectName, 0, len(deps))
+	for _, dep := range deps {
+		selected = append(selected, cache.ObjectName{Namespace: dep.Namespace, Name: dep.Name})
+	}
+
+	c.deletedEksposeSelectionsMu.Lock()
+	defer c.deletedEksposeSelectionsMu.Unlock()
+	c.deletedEksposeSelections[cache.ObjectName{Namespace: eks.Namespace, Name: eks.Name}] = selected
+}
+
+// takeDeletedEksposeSelection returns and forgets the Deployments
+// rememberDeletedEksposeSelection recorded for ns/name, or nil if none were
+// recorded.
+func (c *controller) takeDeletedEksposeSelection(ns, name string) []cache.ObjectName {
+	key := cache.ObjectName{Namespace: ns, Name: name}
+
+	c.deletedEksposeSelectionsMu.Lock()
+	defer c.deletedEksposeSelectionsMu.Unlock()
+	deps := c.deletedEksposeSelections[key]
+	delete(c.deletedEksposeSelections, key)
+	return deps
+}
+
+// enqueue resolves obj's namespace/name (unwrapping a
+// cache.DeletedFinalStateUnknown tombstone for delete events) and adds it to
+// queue.
+func (c *controller) enqueue(queue workqueue.TypedRateLimitingInterface[cache.ObjectName], obj interface{}) {
+	key, err := cache.DeletionHandlingMetaNamespaceKeyFunc(obj)
+	if err != nil {
+		c.logger.Error(err, "getting key from cache")
+		return
+	}
+
+	ns, name, err := cache.SplitMetaNamespaceKey(key)
+	if err != nil {
+		c.logger.Error(err, "spliting key into ns and name")
+		return
+	}
+
+	queue.Add(cache.ObjectName{Namespace: ns, Name: name})
 }
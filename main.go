@@ -0,0 +1,75 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"os/signal"
+	"path/filepath"
+	"syscall"
+	"time"
+
+	"k8s.io/client-go/tools/clientcmd"
+	"k8s.io/client-go/util/homedir"
+
+	eksclientset "github.com/abduln-netapp/k8s-controllers/pkg/generated/clientset/versioned"
+	eksinformers "github.com/abduln-netapp/k8s-controllers/pkg/generated/informers/externalversions"
+	"github.com/abduln-netapp/k8s-controllers/pkg/log"
+
+	"k8s.io/client-go/informers"
+	"k8s.io/client-go/kubernetes"
+)
+
+func main() {
+	var kubeconfig *string
+	if home := homedir.HomeDir(); home != "" {
+		kubeconfig = flag.String("kubeconfig", filepath.Join(home, ".kube", "config"), "path to the kubeconfig file")
+	} else {
+		kubeconfig = flag.String("kubeconfig", "", "path to the kubeconfig file")
+	}
+	workers := flag.Int("workers", 2, "number of workers to run per reconcile loop")
+	var logOpts log.Options
+	logOpts.BindFlags(flag.CommandLine)
+	flag.Parse()
+
+	logger := log.Configure(logOpts)
+
+	config, err := clientcmd.BuildConfigFromFlags("", *kubeconfig)
+	if err != nil {
+		logger.Error(err, "building config from flags")
+		return
+	}
+
+	clientset, err := kubernetes.NewForConfig(config)
+	if err != nil {
+		logger.Error(err, "building kubernetes clientset")
+		return
+	}
+
+	eksClientset, err := eksclientset.NewForConfig(config)
+	if err != nil {
+		logger.Error(err, "building ekspose clientset")
+		return
+	}
+
+	ctx, cancel := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer cancel()
+	ctx = log.NewContext(ctx, logger)
+
+	informerFactory := informers.NewSharedInformerFactory(clientset, 10*time.Minute)
+	eksInformerFactory := eksinformers.NewSharedInformerFactory(eksClientset, 10*time.Minute)
+
+	c := newController(
+		logger,
+		clientset,
+		informerFactory.Apps().V1().Deployments(),
+		eksClientset,
+		eksInformerFactory.Ekspose().V1alpha1().Eksposes(),
+	)
+
+	informerFactory.Start(ctx.Done())
+	eksInformerFactory.Start(ctx.Done())
+
+	if err := c.Run(ctx, *workers); err != nil {
+		logger.Error(err, "running controller")
+	}
+}
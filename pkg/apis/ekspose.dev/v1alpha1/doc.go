@@ -0,0 +1,5 @@
+// +k8s:deepcopy-gen=package
+// +groupName=ekspose.dev
+
+// Package v1alpha1 is the v1alpha1 version of the ekspose.dev API group.
+package v1alpha1
@@ -0,0 +1,84 @@
+package v1alpha1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// Ekspose opts a set of Deployments into exposure through a Service and,
+// optionally, an Ingress managed by the ekspose controller.
+type Ekspose struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   EksposeSpec   `json:"spec"`
+	Status EksposeStatus `json:"status,omitempty"`
+}
+
+// EksposeSpec describes which Deployments to expose and how.
+type EksposeSpec struct {
+	// DeploymentSelector selects the Deployments, in the same namespace as
+	// this Ekspose, that should be exposed.
+	DeploymentSelector metav1.LabelSelector `json:"deploymentSelector"`
+
+	// ServicePort is the port the generated Service listens on.
+	ServicePort int32 `json:"servicePort"`
+
+	// ServiceType is the type of the generated Service. Defaults to
+	// ClusterIP.
+	// +optional
+	ServiceType corev1.ServiceType `json:"serviceType,omitempty"`
+
+	// Ingress configures the optional Ingress routing to the generated
+	// Service. When it is omitted, the Deployment's ekspose.dev/* annotations
+	// decide whether an Ingress is created; with neither, the Deployment is
+	// Service-only.
+	// +optional
+	Ingress *EksposeIngress `json:"ingress,omitempty"`
+}
+
+// EksposeIngress configures the Ingress ekspose creates for a matched
+// Deployment.
+type EksposeIngress struct {
+	Host string `json:"host"`
+
+	// +optional
+	Path string `json:"path,omitempty"`
+
+	// +optional
+	IngressClassName string `json:"ingressClassName,omitempty"`
+
+	// +optional
+	TLSSecretName string `json:"tlsSecretName,omitempty"`
+}
+
+// EksposeStatus reports what the controller last did on behalf of this
+// Ekspose.
+type EksposeStatus struct {
+	// ServiceName is the name of the Service created for the matched
+	// Deployment(s).
+	// +optional
+	ServiceName string `json:"serviceName,omitempty"`
+
+	// IngressName is the name of the Ingress created for the matched
+	// Deployment(s), if any.
+	// +optional
+	IngressName string `json:"ingressName,omitempty"`
+
+	// LastSyncTime is when the controller last reconciled this Ekspose.
+	// +optional
+	LastSyncTime metav1.Time `json:"lastSyncTime,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// EksposeList is a list of Ekspose resources.
+type EksposeList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	Items []Ekspose `json:"items"`
+}
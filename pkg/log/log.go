@@ -0,0 +1,51 @@
+// Package log wires klog/v2 up as the logr.Logger implementation used
+// across ekspose, so every component logs through the same leveled,
+// filterable sink instead of ad-hoc fmt.Print calls.
+package log
+
+import (
+	"context"
+	"flag"
+	"strconv"
+
+	"github.com/go-logr/logr"
+	"k8s.io/klog/v2"
+)
+
+// Options configures the klog-backed logger built by Configure.
+type Options struct {
+	// LogLevel is the klog verbosity (-v) to log at.
+	LogLevel int
+	// AddDirHeader, if true, adds the calling file's directory to the log
+	// message header.
+	AddDirHeader bool
+}
+
+// BindFlags registers --v and --add-dir-header on fs, populating o.
+func (o *Options) BindFlags(fs *flag.FlagSet) {
+	fs.IntVar(&o.LogLevel, "v", 0, "number for the log level verbosity")
+	fs.BoolVar(&o.AddDirHeader, "add-dir-header", false, "if true, adds the file directory to the header of the log messages")
+}
+
+// Configure applies opts to klog's global state and returns the resulting
+// logr.Logger.
+func Configure(opts Options) logr.Logger {
+	klogFlags := flag.NewFlagSet("klog", flag.ContinueOnError)
+	klog.InitFlags(klogFlags)
+	_ = klogFlags.Set("v", strconv.Itoa(opts.LogLevel))
+	_ = klogFlags.Set("add_dir_header", strconv.FormatBool(opts.AddDirHeader))
+
+	return klog.Background()
+}
+
+// FromContext returns the logr.Logger carried on ctx, falling back to a
+// discarding logger if none was ever attached via NewContext.
+func FromContext(ctx context.Context) logr.Logger {
+	return logr.FromContextOrDiscard(ctx)
+}
+
+// NewContext returns a copy of ctx carrying logger, retrievable via
+// FromContext.
+func NewContext(ctx context.Context, logger logr.Logger) context.Context {
+	return logr.NewContext(ctx, logger)
+}